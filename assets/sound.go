@@ -0,0 +1,126 @@
+// Package assets centralizes sound loading and playback so the rest of the
+// game can trigger effects by ID instead of juggling *audio.Player values.
+package assets
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+)
+
+//go:embed sounds/*.wav sounds/*.ogg
+var soundFS embed.FS
+
+// SoundID identifies a sound effect or music track in the atlas.
+type SoundID int
+
+const (
+	SoundLaser SoundID = iota
+	SoundExplosion
+	SoundHit
+	SoundPowerup
+	SoundMusic
+)
+
+// soundPaths maps each SoundID to its file within the embedded atlas.
+var soundPaths = map[SoundID]string{
+	SoundLaser:     "sounds/laser.ogg",
+	SoundExplosion: "sounds/explosion.wav",
+	SoundHit:       "sounds/hit.wav",
+	SoundPowerup:   "sounds/powerup.wav",
+	SoundMusic:     "sounds/music.ogg",
+}
+
+// Manager owns the raw encoded bytes of each sound in the atlas, pre-loaded
+// so playback only has to decode and play rather than hit the filesystem on
+// every trigger. Sound effects are decoded into a fresh *audio.Player per
+// Play call (see Play) so overlapping triggers of the same effect don't cut
+// each other off.
+type Manager struct {
+	context   *audio.Context
+	soundData map[SoundID][]byte
+	music     *audio.Player
+}
+
+// LoadSounds decodes every sound in the atlas up front against ctx, to fail
+// fast on a corrupt asset, and keeps the raw bytes around for playback.
+func LoadSounds(ctx *audio.Context) (*Manager, error) {
+	m := &Manager{
+		context:   ctx,
+		soundData: make(map[SoundID][]byte, len(soundPaths)),
+	}
+
+	for id, path := range soundPaths {
+		f, err := soundFS.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("assets: read %s: %w", path, err)
+		}
+
+		if id == SoundMusic {
+			stream, err := vorbis.DecodeF32(bytes.NewReader(f))
+			if err != nil {
+				return nil, fmt.Errorf("assets: decode %s: %w", path, err)
+			}
+			loop := audio.NewInfiniteLoopF32(stream, stream.Length())
+			player, err := ctx.NewPlayerF32(loop)
+			if err != nil {
+				return nil, fmt.Errorf("assets: decode %s: %w", path, err)
+			}
+			m.music = player
+			continue
+		}
+
+		if _, err := decodePlayer(ctx, path, f); err != nil {
+			return nil, fmt.Errorf("assets: decode %s: %w", path, err)
+		}
+
+		m.soundData[id] = f
+	}
+
+	return m, nil
+}
+
+func decodePlayer(ctx *audio.Context, path string, data []byte) (*audio.Player, error) {
+	if strings.HasSuffix(path, ".wav") {
+		stream, err := wav.DecodeF32(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return ctx.NewPlayerF32(stream)
+	}
+
+	stream, err := vorbis.DecodeF32(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return ctx.NewPlayerF32(stream)
+}
+
+// Play decodes and plays the given sound into a fresh *audio.Player, so the
+// same effect can overlap with itself if triggered again before it finishes.
+func (m *Manager) Play(id SoundID) {
+	data, ok := m.soundData[id]
+	if !ok {
+		return
+	}
+	player, err := decodePlayer(m.context, soundPaths[id], data)
+	if err != nil {
+		return
+	}
+	player.Play()
+}
+
+// PlayMusic starts the looping background track at the given volume
+// (0 to 1). It is a no-op if no music track was loaded.
+func (m *Manager) PlayMusic(volume float64) {
+	if m.music == nil {
+		return
+	}
+	m.music.SetVolume(volume)
+	m.music.Play()
+}
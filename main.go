@@ -14,30 +14,48 @@ import (
 	"image/color"
 	_ "image/png"
 
+	"github.com/8bit-pixies/meteors/assets"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/audio"
-	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
 //go:embed *
-var assets embed.FS
+var assetFS embed.FS
 var PlayerSprite = mustLoadImage("assets/player.png")
 var LaserSprite = mustLoadImage("assets/laser.png")
 var MeteorSprites = mustLoadImages("assets/meteors/*.png")
 var ScoreFont = mustLoadFont("assets/font.ttf")
-var LaserSound = mustLoadSound("assets/laser.ogg")
-
-func mustLoadSound(name string) []byte {
-	f, err := assets.ReadFile(name)
-	if err != nil {
-		panic(err)
+var ThrusterSprite = newThrusterSprite()
+
+// newThrusterSprite procedurally draws a small tapering flame — bright and
+// wide at the top, fading to a point — since there's no hand-authored
+// thruster art to load.
+func newThrusterSprite() *ebiten.Image {
+	const w, h = 12, 22
+	img := ebiten.NewImage(w, h)
+
+	for y := 0; y < h; y++ {
+		t := float64(y) / float64(h)
+		halfWidth := (float64(w) / 2) * (1 - t)
+		fade := uint8(255 * (1 - t))
+
+		for x := 0; x < w; x++ {
+			dx := math.Abs(float64(x) - float64(w)/2)
+			if dx > halfWidth {
+				continue
+			}
+			img.Set(x, y, color.RGBA{255, uint8(180 * (1 - t)), 40, fade})
+		}
 	}
-	return f
+
+	return img
 }
 
 func mustLoadFont(name string) *text.GoTextFaceSource {
-	f, err := assets.ReadFile(name)
+	f, err := assetFS.ReadFile(name)
 	if err != nil {
 		panic(err)
 	}
@@ -49,20 +67,71 @@ func mustLoadFont(name string) *text.GoTextFaceSource {
 }
 
 const (
-	shootCooldown         = time.Millisecond * 500
-	rotationPerSecond     = math.Pi
-	bulletSpawnOffset     = 50.0
-	ScreenWidth           = 800
-	ScreenHeight          = 600
-	meteorSpawnTime       = 1 * time.Second
-	meteorRandOffSet      = 250
-	meteorRandOffSetAngle = 60
-	bulletSpeedPerSecond  = 350.0
-	sampleRate            = 48000
+	shootCooldown        = time.Millisecond * 500
+	rotationPerSecond    = math.Pi
+	bulletSpawnOffset    = 50.0
+	ScreenWidth          = 800
+	ScreenHeight         = 600
+	meteorSplitAngle     = math.Pi / 6 // ±30°
+	bulletSpeedPerSecond = 350.0
+	sampleRate           = 48000
+	thrustPerSecond      = 600.0
+	velocityDamping      = 0.995
 )
 
+// wrap brings v back into [0, max) by adding/subtracting max, for toroidal
+// screen-edge wrapping.
+func wrap(v, max float64) float64 {
+	if v < 0 {
+		return v + max
+	}
+	if v >= max {
+		return v - max
+	}
+	return v
+}
+
+// wrapDrawOffsets returns the extra (dx, dy) translations needed to draw a
+// sprite near a screen edge so its wrapped copy is visible on the opposite
+// edge too — up to 4 draws total (1 original + up to 3 near a corner).
+func wrapDrawOffsets(pos Vector, halfW, halfH float64) []Vector {
+	offsets := []Vector{{X: 0, Y: 0}}
+
+	nearLeft := pos.X < halfW
+	nearRight := pos.X > ScreenWidth-halfW
+	nearTop := pos.Y < halfH
+	nearBottom := pos.Y > ScreenHeight-halfH
+
+	if nearLeft {
+		offsets = append(offsets, Vector{X: ScreenWidth, Y: 0})
+	}
+	if nearRight {
+		offsets = append(offsets, Vector{X: -ScreenWidth, Y: 0})
+	}
+	if nearTop {
+		offsets = append(offsets, Vector{X: 0, Y: ScreenHeight})
+	}
+	if nearBottom {
+		offsets = append(offsets, Vector{X: 0, Y: -ScreenHeight})
+	}
+	if nearLeft && nearTop {
+		offsets = append(offsets, Vector{X: ScreenWidth, Y: ScreenHeight})
+	}
+	if nearLeft && nearBottom {
+		offsets = append(offsets, Vector{X: ScreenWidth, Y: -ScreenHeight})
+	}
+	if nearRight && nearTop {
+		offsets = append(offsets, Vector{X: -ScreenWidth, Y: ScreenHeight})
+	}
+	if nearRight && nearBottom {
+		offsets = append(offsets, Vector{X: -ScreenWidth, Y: -ScreenHeight})
+	}
+
+	return offsets
+}
+
 func mustLoadImage(name string) *ebiten.Image {
-	f, err := assets.Open(name)
+	f, err := assetFS.Open(name)
 	if err != nil {
 		panic(err)
 	}
@@ -77,7 +146,7 @@ func mustLoadImage(name string) *ebiten.Image {
 }
 
 func mustLoadImages(path string) []*ebiten.Image {
-	matches, err := fs.Glob(assets, path)
+	matches, err := fs.Glob(assetFS, path)
 	if err != nil {
 		panic(err)
 	}
@@ -90,57 +159,218 @@ func mustLoadImages(path string) []*ebiten.Image {
 	return images
 }
 
-type Rect struct {
-	X      float64
-	Y      float64
-	Width  float64
-	Height float64
+// circlesIntersect tests two circle colliders by center and radius. It is
+// rotation-invariant, so a spinning sprite's corners never register a
+// false-positive hit the way an axis-aligned box would.
+func circlesIntersect(c1 Vector, r1 float64, c2 Vector, r2 float64) bool {
+	dx := c1.X - c2.X
+	dy := c1.Y - c2.Y
+	radii := r1 + r2
+	return dx*dx+dy*dy <= radii*radii
 }
 
-func NewRect(x, y, width, height float64) Rect {
-	return Rect{
-		X:      x,
-		Y:      y,
-		Width:  width,
-		Height: height,
+type Vector struct {
+	X float64
+	Y float64
+}
+
+func (v Vector) Normalize() Vector {
+	magnitude := math.Sqrt(v.X*v.X + v.Y*v.Y)
+	return Vector{v.X / magnitude, v.Y / magnitude}
+}
+
+// PowerupKind identifies a timed pickup effect a player can hold.
+type PowerupKind int
+
+const (
+	PowerupShield PowerupKind = iota
+	PowerupRapidFire
+	PowerupTripleShot
+	PowerupRepel
+	PowerupFlare
+)
+
+// allPowerupKinds is used to pick a random kind when a powerup drops.
+var allPowerupKinds = []PowerupKind{PowerupShield, PowerupRapidFire, PowerupTripleShot, PowerupRepel, PowerupFlare}
+
+// duration is how long the effect lasts once picked up.
+func (k PowerupKind) duration() time.Duration {
+	switch k {
+	case PowerupShield:
+		return 6 * time.Second
+	case PowerupRapidFire:
+		return 5 * time.Second
+	case PowerupTripleShot:
+		return 6 * time.Second
+	case PowerupRepel:
+		return 5 * time.Second
+	case PowerupFlare:
+		return 3 * time.Second
+	default:
+		return 5 * time.Second
 	}
 }
 
-func (r Rect) MaxX() float64 {
-	return r.X + r.Width
+// color is used both for the drifting pickup sprite and its remaining-time
+// ring under the player.
+func (k PowerupKind) color() color.Color {
+	switch k {
+	case PowerupShield:
+		return color.RGBA{80, 160, 255, 255}
+	case PowerupRapidFire:
+		return color.RGBA{255, 200, 60, 255}
+	case PowerupTripleShot:
+		return color.RGBA{255, 90, 90, 255}
+	case PowerupRepel:
+		return color.RGBA{160, 255, 160, 255}
+	case PowerupFlare:
+		return color.RGBA{255, 245, 200, 255}
+	default:
+		return color.White
+	}
 }
 
-func (r Rect) MaxY() float64 {
-	return r.Y + r.Height
+const (
+	powerupRadius        = 10.0
+	powerupDriftSpeed    = 0.3
+	powerupDropChance    = 0.2
+	rapidFireCooldown    = 150 * time.Millisecond
+	tripleShotAngle      = math.Pi / 12 // ±15°
+	repelRadius          = 120.0
+	repelSpeedMultiplier = 1.2
+)
+
+// Powerup is a drifting pickup spawned when a meteor is destroyed. Colliding
+// with the player activates its effect for PowerupKind.duration().
+type Powerup struct {
+	position Vector
+	movement Vector
+	kind     PowerupKind
 }
 
-func (r Rect) Intersects(other Rect) bool {
-	return r.X <= other.MaxX() &&
-		other.X <= r.MaxX() &&
-		r.Y <= other.MaxY() &&
-		other.Y <= r.MaxY()
+func NewPowerup(pos Vector, kind PowerupKind) *Powerup {
+	angle := rand.Float64() * 2 * math.Pi
+
+	return &Powerup{
+		position: pos,
+		kind:     kind,
+		movement: Vector{X: math.Cos(angle) * powerupDriftSpeed, Y: math.Sin(angle) * powerupDriftSpeed},
+	}
 }
 
-type Vector struct {
-	X float64
-	Y float64
+func (p *Powerup) Update() {
+	p.position.X += p.movement.X
+	p.position.Y += p.movement.Y
 }
 
-func (v Vector) Normalize() Vector {
-	magnitude := math.Sqrt(v.X*v.X + v.Y*v.Y)
-	return Vector{v.X / magnitude, v.Y / magnitude}
+func (p *Powerup) Draw(screen *ebiten.Image) {
+	vector.DrawFilledCircle(screen, float32(p.position.X), float32(p.position.Y), powerupRadius, p.kind.color(), true)
 }
 
+// Center and Radius satisfy the same circle-collider shape as the other
+// entities; a Powerup's position is already its center.
+func (p *Powerup) Center() Vector  { return p.position }
+func (p *Powerup) Radius() float64 { return powerupRadius }
+
 type Player struct {
 	game *Game
 
 	position      Vector
+	velocity      Vector
 	rotation      float64
 	sprite        *ebiten.Image
 	shootCooldown *Timer
+	thrusting     bool
+
+	activeEffects map[PowerupKind]time.Time
+}
+
+// activate starts (or refreshes) a timed effect.
+func (p *Player) activate(kind PowerupKind) {
+	if p.activeEffects == nil {
+		p.activeEffects = make(map[PowerupKind]time.Time)
+	}
+	p.activeEffects[kind] = time.Now().Add(kind.duration())
+}
 
-	laserAudio       *audio.Context
-	laserAudioPlayer *audio.Player
+// hasEffect reports whether kind is currently active.
+func (p *Player) hasEffect(kind PowerupKind) bool {
+	_, ok := p.activeEffects[kind]
+	return ok
+}
+
+// consumeEffect removes kind immediately, used by one-shot effects like the
+// shield absorbing a single hit.
+func (p *Player) consumeEffect(kind PowerupKind) {
+	delete(p.activeEffects, kind)
+}
+
+// pruneEffects drops any effect whose deadline has passed.
+func (p *Player) pruneEffects() {
+	now := time.Now()
+	for kind, expiry := range p.activeEffects {
+		if now.After(expiry) {
+			delete(p.activeEffects, kind)
+		}
+	}
+}
+
+// effectRemaining returns how much time is left on kind, if active.
+func (p *Player) effectRemaining(kind PowerupKind) (time.Duration, bool) {
+	expiry, ok := p.activeEffects[kind]
+	if !ok {
+		return 0, false
+	}
+	return time.Until(expiry), true
+}
+
+// MeteorSize is a classic-asteroids size tier. Destroying a large or medium
+// meteor splits it into two of the next-smaller tier; small meteors are
+// destroyed outright.
+type MeteorSize int
+
+const (
+	SizeLarge MeteorSize = iota
+	SizeMedium
+	SizeSmall
+)
+
+// scale is the sprite/collider scale factor for each size tier.
+func (s MeteorSize) scale() float64 {
+	switch s {
+	case SizeMedium:
+		return 0.65
+	case SizeSmall:
+		return 0.35
+	default:
+		return 1.0
+	}
+}
+
+// score is the points awarded for destroying a meteor of this size —
+// smaller meteors are worth more since they're harder to hit.
+func (s MeteorSize) score() int {
+	switch s {
+	case SizeMedium:
+		return 2
+	case SizeSmall:
+		return 3
+	default:
+		return 1
+	}
+}
+
+// next is the size tier spawned when a meteor of this size is destroyed,
+// and ok reports whether a split happens at all (false for SizeSmall).
+func (s MeteorSize) next() (MeteorSize, bool) {
+	switch s {
+	case SizeLarge:
+		return SizeMedium, true
+	case SizeMedium:
+		return SizeSmall, true
+	default:
+		return 0, false
+	}
 }
 
 type Meteor struct {
@@ -149,22 +379,25 @@ type Meteor struct {
 	rotation      float64
 	rotationSpeed float64
 	sprite        *ebiten.Image
+	size          MeteorSize
 }
 
-func NewMeteor() *Meteor {
+func NewMeteor(level Level) *Meteor {
 	sprite := MeteorSprites[rand.Intn(len(MeteorSprites))]
 
 	// Figure out the target position — the screen center, in this case
+	offset := int(level.meteorSpawnOffset)
 	target := Vector{
-		X: ScreenWidth/2 + float64(rand.Intn(meteorRandOffSet)) - float64(meteorRandOffSet)/2,
-		Y: ScreenHeight/2 + float64(rand.Intn(meteorRandOffSet)) - float64(meteorRandOffSet)/2,
+		X: ScreenWidth/2 + float64(rand.Intn(offset)) - level.meteorSpawnOffset/2,
+		Y: ScreenHeight/2 + float64(rand.Intn(offset)) - level.meteorSpawnOffset/2,
 	}
 
 	// The distance from the center the meteor should spawn at — half the width
 	r := ScreenWidth / 2.0
 
 	// Pick a random angle — 2π is 360° — so this returns 0° to 360°
-	angle := rand.Float64()*2*math.Pi + float64(rand.Intn(meteorRandOffSetAngle)) - float64(meteorRandOffSetAngle)/2
+	angleOffset := int(level.meteorSpawnOffsetAngle)
+	angle := rand.Float64()*2*math.Pi + float64(rand.Intn(angleOffset)) - level.meteorSpawnOffsetAngle/2
 
 	// Figure out the spawn position by moving r pixels from the target at the chosen angle
 	pos := Vector{
@@ -172,8 +405,8 @@ func NewMeteor() *Meteor {
 		Y: target.Y + math.Sin(angle)*r,
 	}
 
-	// Randomized velocity
-	velocity := 0.25 + rand.Float64()*1.5
+	// Randomized velocity, scaled by the current level's speed range
+	velocity := level.meteorMinSpeed + rand.Float64()*(level.meteorMaxSpeed-level.meteorMinSpeed)
 
 	rotationSpeed := -0.02 + rand.Float64()*0.04
 
@@ -197,12 +430,36 @@ func NewMeteor() *Meteor {
 		sprite:        sprite,
 		movement:      movement,
 		rotationSpeed: rotationSpeed,
+		size:          SizeLarge,
+	}
+}
+
+// splitMeteor builds a smaller meteor spawned from a destroyed parent. Its
+// movement vector is the parent's, rotated by angleOffset radians and sped
+// up, so the two children fly apart from where the parent broke.
+func splitMeteor(parent *Meteor, size MeteorSize, angleOffset float64) *Meteor {
+	const speedBoost = 1.4
+
+	cos, sin := math.Cos(angleOffset), math.Sin(angleOffset)
+	movement := Vector{
+		X: (parent.movement.X*cos - parent.movement.Y*sin) * speedBoost,
+		Y: (parent.movement.X*sin + parent.movement.Y*cos) * speedBoost,
+	}
+
+	return &Meteor{
+		position:      parent.position,
+		sprite:        parent.sprite,
+		movement:      movement,
+		rotationSpeed: parent.rotationSpeed,
+		size:          size,
 	}
 }
 
 func (m *Meteor) Update() {
 	m.position.X += m.movement.X
 	m.position.Y += m.movement.Y
+	m.position.X = wrap(m.position.X, ScreenWidth)
+	m.position.Y = wrap(m.position.Y, ScreenHeight)
 	m.rotation += m.rotationSpeed
 }
 
@@ -210,25 +467,41 @@ func (m *Meteor) Draw(screen *ebiten.Image) {
 	bounds := m.sprite.Bounds()
 	halfW := float64(bounds.Dx()) / 2
 	halfH := float64(bounds.Dy()) / 2
-
-	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Translate(-halfW, -halfH)
-	op.GeoM.Rotate(m.rotation)
-	op.GeoM.Translate(halfW, halfH)
-	op.GeoM.Translate(m.position.X, m.position.Y)
-
-	screen.DrawImage(m.sprite, op)
+	scale := m.size.scale()
+
+	base := ebiten.GeoM{}
+	base.Translate(-halfW, -halfH)
+	base.Rotate(m.rotation)
+	base.Scale(scale, scale)
+	base.Translate(halfW, halfH)
+
+	for _, off := range wrapDrawOffsets(m.position, halfW*scale, halfH*scale) {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM = base
+		op.GeoM.Translate(m.position.X+off.X, m.position.Y+off.Y)
+		screen.DrawImage(m.sprite, op)
+	}
 }
 
-func (m *Meteor) Collider() Rect {
+// Center is the sprite's midpoint in world space, used by the circle-based
+// collision test. Draw scales the sprite around its own unscaled center, so
+// the on-screen midpoint is always position + the unscaled half-extent,
+// regardless of size — only Radius should account for scale.
+func (m *Meteor) Center() Vector {
 	bounds := m.sprite.Bounds()
+	return Vector{
+		X: m.position.X + float64(bounds.Dx())/2,
+		Y: m.position.Y + float64(bounds.Dy())/2,
+	}
+}
 
-	return NewRect(
-		m.position.X,
-		m.position.Y,
-		float64(bounds.Dx()),
-		float64(bounds.Dy()),
-	)
+// Radius is the sprite's inscribed-circle radius, so a rotated meteor's
+// collider doesn't false-positive at its (empty) corners the way its AABB
+// would.
+func (m *Meteor) Radius() float64 {
+	bounds := m.sprite.Bounds()
+	scale := m.size.scale()
+	return math.Min(float64(bounds.Dx()), float64(bounds.Dy())) * scale / 2
 }
 
 func NewPlayer(game *Game) *Player {
@@ -243,22 +516,18 @@ func NewPlayer(game *Game) *Player {
 		Y: ScreenHeight/2 - halfH,
 	}
 
-	audioContext := audio.NewContext(sampleRate)
-	laserSound, _ := vorbis.DecodeF32(bytes.NewReader(LaserSound))
-	player, _ := audioContext.NewPlayerF32(laserSound)
 	return &Player{
 		game:          game,
 		position:      pos,
 		sprite:        sprite,
 		rotation:      0,
 		shootCooldown: NewTimer(shootCooldown),
-
-		laserAudio:       audioContext,
-		laserAudioPlayer: player,
 	}
 }
 
 func (p *Player) Update() {
+	p.pruneEffects()
+
 	speed := math.Pi / float64(ebiten.TPS())
 
 	if ebiten.IsKeyPressed(ebiten.KeyLeft) || ebiten.IsKeyPressed(ebiten.KeyA) {
@@ -268,6 +537,28 @@ func (p *Player) Update() {
 		p.rotation += speed
 	}
 
+	p.thrusting = ebiten.IsKeyPressed(ebiten.KeyUp) || ebiten.IsKeyPressed(ebiten.KeyW)
+	if p.thrusting {
+		heading := Vector{X: math.Sin(p.rotation), Y: -math.Cos(p.rotation)}
+		accel := thrustPerSecond / float64(ebiten.TPS())
+		p.velocity.X += heading.X * accel
+		p.velocity.Y += heading.Y * accel
+	}
+
+	p.velocity.X *= velocityDamping
+	p.velocity.Y *= velocityDamping
+
+	p.position.X += p.velocity.X / float64(ebiten.TPS())
+	p.position.Y += p.velocity.Y / float64(ebiten.TPS())
+	p.position.X = wrap(p.position.X, ScreenWidth)
+	p.position.Y = wrap(p.position.Y, ScreenHeight)
+
+	if p.hasEffect(PowerupRapidFire) {
+		p.shootCooldown.SetTarget(rapidFireCooldown)
+	} else {
+		p.shootCooldown.SetTarget(shootCooldown)
+	}
+
 	p.shootCooldown.Update()
 	if p.shootCooldown.IsReady() && ebiten.IsKeyPressed(ebiten.KeySpace) {
 		p.shootCooldown.Reset()
@@ -281,37 +572,106 @@ func (p *Player) Update() {
 			p.position.Y + halfH + math.Cos(p.rotation)*-bulletSpawnOffset,
 		}
 
-		bullet := NewBullet(spawnPos, p.rotation)
-		p.game.AddBullet(bullet)
-		p.laserAudioPlayer.SetPosition(0)
-		p.laserAudioPlayer.Play()
+		angles := []float64{0}
+		if p.hasEffect(PowerupTripleShot) {
+			angles = []float64{-tripleShotAngle, 0, tripleShotAngle}
+		}
+
+		for _, offset := range angles {
+			bullet := NewBullet(spawnPos, p.rotation+offset)
+			p.game.AddBullet(bullet)
+		}
+		p.game.sounds.Play(assets.SoundLaser)
+	}
+}
+
+// drawEffectRings draws a shrinking ring under the player for each active
+// powerup, sized by the fraction of its duration remaining.
+func (p *Player) drawEffectRings(screen *ebiten.Image) {
+	bounds := p.sprite.Bounds()
+	cx := float32(p.position.X + float64(bounds.Dx())/2)
+	cy := float32(p.position.Y + float64(bounds.Dy())/2)
+
+	ring := float32(0)
+	for _, kind := range allPowerupKinds {
+		remaining, ok := p.effectRemaining(kind)
+		if !ok {
+			continue
+		}
+
+		frac := remaining.Seconds() / kind.duration().Seconds()
+		radius := float32(18) + ring*8
+		vector.StrokeCircle(screen, cx, cy, radius*float32(frac), 2, kind.color(), true)
+		ring++
+	}
+}
+
+// drawThruster draws the flame sprite behind the ship, opposite its heading,
+// while thrust is being applied.
+func (p *Player) drawThruster(screen *ebiten.Image, offsets []Vector) {
+	if !p.thrusting {
+		return
+	}
+
+	bounds := p.sprite.Bounds()
+	flameBounds := ThrusterSprite.Bounds()
+	flameHalfW := float64(flameBounds.Dx()) / 2
+
+	center := Vector{
+		X: p.position.X + float64(bounds.Dx())/2,
+		Y: p.position.Y + float64(bounds.Dy())/2,
+	}
+	rear := Vector{
+		X: center.X - math.Sin(p.rotation)*float64(bounds.Dy())/2,
+		Y: center.Y + math.Cos(p.rotation)*float64(bounds.Dy())/2,
+	}
+
+	for _, off := range offsets {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(-flameHalfW, 0)
+		op.GeoM.Rotate(p.rotation)
+		op.GeoM.Translate(rear.X+off.X, rear.Y+off.Y)
+		screen.DrawImage(ThrusterSprite, op)
 	}
 }
 
 func (p *Player) Draw(screen *ebiten.Image) {
+	p.drawEffectRings(screen)
+
 	bounds := p.sprite.Bounds()
 	halfW := float64(bounds.Dx()) / 2
 	halfH := float64(bounds.Dy()) / 2
 
-	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Translate(-halfW, -halfH)
-	op.GeoM.Rotate(p.rotation)
-	op.GeoM.Translate(halfW, halfH)
+	offsets := wrapDrawOffsets(p.position, halfW, halfH)
+
+	p.drawThruster(screen, offsets)
 
-	op.GeoM.Translate(p.position.X, p.position.Y)
+	base := ebiten.GeoM{}
+	base.Translate(-halfW, -halfH)
+	base.Rotate(p.rotation)
+	base.Translate(halfW, halfH)
 
-	screen.DrawImage(p.sprite, op)
+	for _, off := range offsets {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM = base
+		op.GeoM.Translate(p.position.X+off.X, p.position.Y+off.Y)
+		screen.DrawImage(p.sprite, op)
+	}
 }
 
-func (p *Player) Collider() Rect {
+// Center and Radius give the player's inscribed collision circle, used by
+// circlesIntersect so a rotated ship doesn't false-positive at its corners.
+func (p *Player) Center() Vector {
 	bounds := p.sprite.Bounds()
+	return Vector{
+		X: p.position.X + float64(bounds.Dx())/2,
+		Y: p.position.Y + float64(bounds.Dy())/2,
+	}
+}
 
-	return NewRect(
-		p.position.X,
-		p.position.Y,
-		float64(bounds.Dx()),
-		float64(bounds.Dy()),
-	)
+func (p *Player) Radius() float64 {
+	bounds := p.sprite.Bounds()
+	return math.Min(float64(bounds.Dx()), float64(bounds.Dy())) / 2
 }
 
 type Bullet struct {
@@ -359,23 +719,403 @@ func (b *Bullet) Draw(screen *ebiten.Image) {
 	screen.DrawImage(b.sprite, op)
 }
 
-func (b *Bullet) Collider() Rect {
+// Center and Radius give the bullet's inscribed collision circle.
+func (b *Bullet) Center() Vector {
+	bounds := b.sprite.Bounds()
+	return Vector{
+		X: b.position.X + float64(bounds.Dx())/2,
+		Y: b.position.Y + float64(bounds.Dy())/2,
+	}
+}
+
+func (b *Bullet) Radius() float64 {
 	bounds := b.sprite.Bounds()
+	return math.Min(float64(bounds.Dx()), float64(bounds.Dy())) / 2
+}
+
+type GameState int
+
+const (
+	StateMenu GameState = iota
+	StatePlaying
+	StatePaused
+	StateGameOver
+	StateLevelComplete
+)
+
+const startingLives = 3
+
+// Level describes the difficulty curve for one wave of play: how often
+// meteors spawn, how fast they move, how much their spawn point and angle
+// scatter, and how much score is needed to advance to the next level.
+type Level struct {
+	meteorSpawnInterval    time.Duration
+	meteorMinSpeed         float64
+	meteorMaxSpeed         float64
+	meteorSpawnOffset      float64 // scatter added to the spawn point, in pixels
+	meteorSpawnOffsetAngle float64 // scatter added as is to the spawn angle, which is in radians
+	scoreToAdvance         int
+	enemyTypes             []string // reserved for future enemy variants; unused today
+}
+
+// levels holds hand-authored difficulty curves for the first few waves.
+// Beyond the last entry, levelFor extrapolates harder levels by scaling
+// the final entry.
+var levels = []Level{
+	{meteorSpawnInterval: 1000 * time.Millisecond, meteorMinSpeed: 0.25, meteorMaxSpeed: 1.75, meteorSpawnOffset: 250, meteorSpawnOffsetAngle: 60, scoreToAdvance: 10},
+	{meteorSpawnInterval: 800 * time.Millisecond, meteorMinSpeed: 0.4, meteorMaxSpeed: 2.1, meteorSpawnOffset: 250, meteorSpawnOffsetAngle: 60, scoreToAdvance: 25},
+	{meteorSpawnInterval: 650 * time.Millisecond, meteorMinSpeed: 0.6, meteorMaxSpeed: 2.5, meteorSpawnOffset: 250, meteorSpawnOffsetAngle: 60, scoreToAdvance: 45},
+	{meteorSpawnInterval: 500 * time.Millisecond, meteorMinSpeed: 0.8, meteorMaxSpeed: 3.0, meteorSpawnOffset: 250, meteorSpawnOffsetAngle: 60, scoreToAdvance: 70},
+	{meteorSpawnInterval: 400 * time.Millisecond, meteorMinSpeed: 1.0, meteorMaxSpeed: 3.5, meteorSpawnOffset: 250, meteorSpawnOffsetAngle: 60, scoreToAdvance: 100},
+}
+
+// levelFor returns the difficulty curve for levelNum (1-indexed). Levels
+// beyond the hand-authored slice keep escalating by scaling the last entry.
+func levelFor(levelNum int) Level {
+	if levelNum <= len(levels) {
+		return levels[levelNum-1]
+	}
+
+	last := levels[len(levels)-1]
+	extra := levelNum - len(levels)
+	scale := 1.0 + 0.15*float64(extra)
+
+	interval := time.Duration(float64(last.meteorSpawnInterval) / scale)
+	if interval < 150*time.Millisecond {
+		interval = 150 * time.Millisecond
+	}
+
+	return Level{
+		meteorSpawnInterval:    interval,
+		meteorMinSpeed:         last.meteorMinSpeed * scale,
+		meteorMaxSpeed:         last.meteorMaxSpeed * scale,
+		meteorSpawnOffset:      last.meteorSpawnOffset,
+		meteorSpawnOffsetAngle: last.meteorSpawnOffsetAngle,
+		scoreToAdvance:         last.scoreToAdvance + extra*35,
+		enemyTypes:             last.enemyTypes,
+	}
+}
+
+type flashMessage struct {
+	text   string
+	expiry time.Time
+}
+
+// backgroundLayerSpeeds are the parallax scroll factors for each starfield
+// layer, from furthest (slowest) to nearest (fastest).
+var backgroundLayerSpeeds = [3]float64{0.25, 0.5, 1.0}
+
+// Background is a multi-layer starfield drawn behind every other entity.
+// Each layer is a tiled star image scrolled at its own fraction of the
+// camera offset, giving a cheap sense of depth.
+type Background struct {
+	layers [3]*ebiten.Image
+}
+
+// NewBackground procedurally generates the starfield layers. Nearer layers
+// (higher scroll speed) get fewer, brighter stars so they read as closer.
+func NewBackground() *Background {
+	bg := &Background{}
+	starCounts := [3]int{160, 90, 40}
+
+	for i := range bg.layers {
+		img := ebiten.NewImage(ScreenWidth, ScreenHeight)
+		brightness := uint8(120 + i*60)
+		for n := 0; n < starCounts[i]; n++ {
+			x := rand.Intn(ScreenWidth)
+			y := rand.Intn(ScreenHeight)
+			img.Set(x, y, color.RGBA{brightness, brightness, brightness, 255})
+		}
+		bg.layers[i] = img
+	}
+
+	return bg
+}
+
+// Draw tiles each layer across the screen, offset by its share of the
+// camera offset, wrapping modulo the screen size so the scroll looks
+// seamless in every direction.
+func (bg *Background) Draw(screen *ebiten.Image, cameraOffset Vector) {
+	for i, layer := range bg.layers {
+		speed := backgroundLayerSpeeds[i]
+		ox := math.Mod(cameraOffset.X*speed, ScreenWidth)
+		oy := math.Mod(cameraOffset.Y*speed, ScreenHeight)
+
+		for tileX := -1; tileX <= 1; tileX++ {
+			for tileY := -1; tileY <= 1; tileY++ {
+				op := &ebiten.DrawImageOptions{}
+				op.GeoM.Translate(float64(tileX)*ScreenWidth-ox, float64(tileY)*ScreenHeight-oy)
+				screen.DrawImage(layer, op)
+			}
+		}
+	}
+}
+
+// lightSource is one radial "hole" punched into the darkness overlay.
+type lightSource struct {
+	position Vector
+	radius   float64
+}
+
+// Lighting darkens the scene each frame and carves out radial light around
+// the player, bullets, and recent explosions, so illumination becomes part
+// of the gameplay rather than a static rendering concern.
+type Lighting struct {
+	overlay *ebiten.Image
+	radial  *ebiten.Image
+}
+
+// NewLighting builds the reusable overlay buffer and the radial gradient
+// sprite used to erase darkness from it.
+func NewLighting() *Lighting {
+	return &Lighting{
+		overlay: ebiten.NewImage(ScreenWidth, ScreenHeight),
+		radial:  newRadialGradient(256),
+	}
+}
+
+// newRadialGradient draws a white circle whose alpha fades from opaque at
+// the center to transparent at the edge — used as an eraser mask, so only
+// its alpha channel matters.
+func newRadialGradient(size int) *ebiten.Image {
+	img := ebiten.NewImage(size, size)
+	center := float64(size) / 2
+
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			dx := float64(x) - center
+			dy := float64(y) - center
+			dist := math.Sqrt(dx*dx+dy*dy) / center
+			if dist > 1 {
+				continue
+			}
+			alpha := uint8(255 * (1 - dist))
+			img.Set(x, y, color.RGBA{255, 255, 255, alpha})
+		}
+	}
+
+	return img
+}
+
+// Draw fills target with near-black at (1-brightness) opacity, then erases
+// a radial hole around each light source using destination-out compositing
+// so the scene beneath shows through. brightness >= 1 skips the overlay
+// entirely (full bright / debug mode).
+func (l *Lighting) Draw(target *ebiten.Image, sources []lightSource, brightness float64) {
+	if brightness >= 1 {
+		return
+	}
 
-	return NewRect(
-		b.position.X,
-		b.position.Y,
-		float64(bounds.Dx()),
-		float64(bounds.Dy()),
-	)
+	darkAlpha := uint8(255 * (1 - brightness))
+	l.overlay.Clear()
+	l.overlay.Fill(color.RGBA{0, 0, 0, darkAlpha})
+
+	radialSize := float64(l.radial.Bounds().Dx())
+	for _, src := range sources {
+		op := &ebiten.DrawImageOptions{}
+		scale := (src.radius * 2) / radialSize
+		op.GeoM.Scale(scale, scale)
+		op.GeoM.Translate(src.position.X-src.radius, src.position.Y-src.radius)
+		op.CompositeMode = ebiten.CompositeModeDestinationOut
+		l.overlay.DrawImage(l.radial, op)
+	}
+
+	target.DrawImage(l.overlay, nil)
+}
+
+// spatialCellSize is the width/height of a spatialGrid cell. It is sized a
+// little larger than a large meteor's sprite so most queries only need to
+// look at a handful of neighbouring cells.
+const spatialCellSize = 64.0
+
+// cellKey addresses a single cell of a spatialGrid.
+type cellKey struct {
+	x int
+	y int
+}
+
+// spatialGrid is a uniform grid used for broad-phase collision queries. It
+// is rebuilt from scratch every tick, which is cheap relative to the
+// brute-force all-pairs scan it replaces once entity counts grow.
+type spatialGrid struct {
+	cellSize float64
+	cells    map[cellKey][]int
+}
+
+func newSpatialGrid(cellSize float64) *spatialGrid {
+	return &spatialGrid{
+		cellSize: cellSize,
+		cells:    make(map[cellKey][]int),
+	}
+}
+
+func (g *spatialGrid) keyFor(pos Vector) cellKey {
+	return cellKey{
+		x: int(math.Floor(pos.X / g.cellSize)),
+		y: int(math.Floor(pos.Y / g.cellSize)),
+	}
+}
+
+// insert records index under the cell containing pos.
+func (g *spatialGrid) insert(index int, pos Vector) {
+	key := g.keyFor(pos)
+	g.cells[key] = append(g.cells[key], index)
+}
+
+// nearby returns the indices stored in pos's cell and its eight neighbours.
+func (g *spatialGrid) nearby(pos Vector) []int {
+	center := g.keyFor(pos)
+
+	var result []int
+	for dx := -1; dx <= 1; dx++ {
+		for dy := -1; dy <= 1; dy++ {
+			key := cellKey{x: center.x + dx, y: center.y + dy}
+			result = append(result, g.cells[key]...)
+		}
+	}
+	return result
 }
 
 type Game struct {
+	state GameState
+
 	player           *Player
 	meteorSpawnTimer *Timer
 	meteors          []*Meteor
 	bullets          []*Bullet
+	powerups         []*Powerup
+	meteorGrid       *spatialGrid
 	score            int
+	lives            int
+
+	levelNum int
+
+	background   *Background
+	cameraShake  float64
+	renderBuffer *ebiten.Image
+
+	lighting           *Lighting
+	explosionFlashes   []explosionFlash
+	minLevelColorScale float64
+	fullBrightMode     bool
+
+	sounds *assets.Manager
+
+	flash flashMessage
+}
+
+// explosionFlash is a short-lived light source left where a meteor died.
+type explosionFlash struct {
+	position Vector
+	expiry   time.Time
+}
+
+// reset reinitialises the playable state so a fresh run can begin without
+// restarting the process.
+func (g *Game) reset() {
+	g.player = NewPlayer(g)
+	g.meteors = nil
+	g.bullets = nil
+	g.powerups = nil
+	g.score = 0
+	g.lives = startingLives
+	g.levelNum = 1
+	g.meteorSpawnTimer = NewTimer(levelFor(g.levelNum).meteorSpawnInterval)
+	g.background = NewBackground()
+	g.cameraShake = 0
+	g.lighting = NewLighting()
+	g.explosionFlashes = nil
+	g.minLevelColorScale = 0.35
+}
+
+// currentBrightness returns the scene's overall brightness (0 dark to 1
+// full), which drops a little each level down to minLevelColorScale, unless
+// debug full-bright mode or an active PowerupFlare overrides it.
+func (g *Game) currentBrightness() float64 {
+	if g.fullBrightMode {
+		return 1
+	}
+	if g.player.hasEffect(PowerupFlare) {
+		return 1
+	}
+
+	brightness := 1 - float64(g.levelNum-1)*0.08
+	if brightness < g.minLevelColorScale {
+		brightness = g.minLevelColorScale
+	}
+	return brightness
+}
+
+// cameraOffset derives a virtual camera offset from the player's position
+// and heading, used to scroll the parallax background.
+func (g *Game) cameraOffset() Vector {
+	return Vector{
+		X: g.player.position.X*0.05 + math.Sin(g.player.rotation)*8,
+		Y: g.player.position.Y*0.05 + math.Cos(g.player.rotation)*-8,
+	}
+}
+
+// bumpShake increases the camera shake intensity; it decays back to zero
+// exponentially every tick in updatePlaying.
+func (g *Game) bumpShake(amount float64) {
+	g.cameraShake += amount
+}
+
+// lightSources collects everything the lighting overlay should carve a
+// hole around: the player, every bullet in flight, and any recent
+// explosion that hasn't faded yet.
+func (g *Game) lightSources() []lightSource {
+	sources := make([]lightSource, 0, 2+len(g.bullets)+len(g.explosionFlashes))
+
+	bounds := g.player.sprite.Bounds()
+	sources = append(sources, lightSource{
+		position: Vector{X: g.player.position.X + float64(bounds.Dx())/2, Y: g.player.position.Y + float64(bounds.Dy())/2},
+		radius:   140,
+	})
+
+	for _, b := range g.bullets {
+		sources = append(sources, lightSource{position: b.position, radius: 40})
+	}
+
+	for _, f := range g.explosionFlashes {
+		sources = append(sources, lightSource{position: f.position, radius: 90})
+	}
+
+	return sources
+}
+
+// applyRepel pushes any meteor within repelRadius of the player away from
+// it, for the duration of an active PowerupRepel effect.
+func (g *Game) applyRepel() {
+	bounds := g.player.sprite.Bounds()
+	center := Vector{
+		X: g.player.position.X + float64(bounds.Dx())/2,
+		Y: g.player.position.Y + float64(bounds.Dy())/2,
+	}
+
+	for _, m := range g.meteors {
+		away := Vector{X: m.position.X - center.X, Y: m.position.Y - center.Y}
+		dist := math.Sqrt(away.X*away.X + away.Y*away.Y)
+		if dist == 0 || dist >= repelRadius {
+			continue
+		}
+
+		speed := math.Sqrt(m.movement.X*m.movement.X+m.movement.Y*m.movement.Y) * repelSpeedMultiplier
+		normalized := away.Normalize()
+		m.movement = Vector{X: normalized.X * speed, Y: normalized.Y * speed}
+	}
+}
+
+// advanceLevel moves to the next level's difficulty curve and rebuilds the
+// spawn timer to match it.
+func (g *Game) advanceLevel() {
+	g.levelNum++
+	g.meteorSpawnTimer = NewTimer(levelFor(g.levelNum).meteorSpawnInterval)
+}
+
+// showFlash displays a transient on-screen message for the given duration.
+func (g *Game) showFlash(text string, d time.Duration) {
+	g.flash = flashMessage{text: text, expiry: time.Now().Add(d)}
 }
 
 type Timer struct {
@@ -404,14 +1144,89 @@ func (t *Timer) Reset() {
 	t.currentTicks = 0
 }
 
+// SetTarget changes the timer's duration without resetting its progress,
+// so an in-flight cooldown can speed up or slow down (e.g. rapid fire).
+func (t *Timer) SetTarget(d time.Duration) {
+	t.targetTicks = int(d.Milliseconds()) * ebiten.TPS() / 1000
+}
+
 func (g *Game) Update() error {
+	switch g.state {
+	case StateMenu:
+		g.updateMenu()
+	case StatePlaying:
+		g.updatePlaying()
+	case StatePaused:
+		g.updatePaused()
+	case StateGameOver:
+		g.updateGameOver()
+	case StateLevelComplete:
+		g.updateLevelComplete()
+	}
+	return nil
+}
+
+func (g *Game) updateMenu() {
+	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		g.reset()
+		g.state = StatePlaying
+	}
+}
+
+func (g *Game) updatePaused() {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.state = StatePlaying
+	}
+}
+
+func (g *Game) updateGameOver() {
+	if inpututil.IsKeyJustPressed(ebiten.KeySpace) {
+		g.reset()
+		g.state = StatePlaying
+	}
+}
+
+func (g *Game) updateLevelComplete() {
+	if time.Now().After(g.flash.expiry) {
+		g.advanceLevel()
+		g.state = StatePlaying
+	}
+}
+
+func (g *Game) updatePlaying() {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		g.state = StatePaused
+		g.showFlash("Paused", time.Hour)
+		return
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyF1) {
+		g.fullBrightMode = !g.fullBrightMode
+	}
+
 	g.player.Update()
 
+	g.cameraShake *= 0.9
+	if g.cameraShake < 0.05 {
+		g.cameraShake = 0
+	}
+
+	now := time.Now()
+	liveFlashes := g.explosionFlashes[:0]
+	for _, f := range g.explosionFlashes {
+		if now.Before(f.expiry) {
+			liveFlashes = append(liveFlashes, f)
+		}
+	}
+	g.explosionFlashes = liveFlashes
+
+	level := levelFor(g.levelNum)
+
 	g.meteorSpawnTimer.Update()
 	if g.meteorSpawnTimer.IsReady() {
 		g.meteorSpawnTimer.Reset()
 
-		m := NewMeteor()
+		m := NewMeteor(level)
 		g.meteors = append(g.meteors, m)
 	}
 
@@ -423,47 +1238,227 @@ func (g *Game) Update() error {
 		b.Update()
 	}
 
-	// Check for meteor/bullet collisions
+	for _, pu := range g.powerups {
+		pu.Update()
+	}
+
+	if g.player.hasEffect(PowerupRepel) {
+		g.applyRepel()
+	}
+
+	// Rebuild the broad-phase grid from this tick's meteor positions before
+	// running any collision queries against it.
+	g.meteorGrid = newSpatialGrid(spatialCellSize)
 	for i, m := range g.meteors {
-		for j, b := range g.bullets {
-			if m.Collider().Intersects(b.Collider()) {
-				g.meteors = append(g.meteors[:i], g.meteors[i+1:]...)
-				g.bullets = append(g.bullets[:j], g.bullets[j+1:]...)
-				g.score++
+		g.meteorGrid.insert(i, m.Center())
+	}
+
+	// Check for meteor/bullet collisions. Destroyed indices are only
+	// recorded here; the slices are compacted once below so that removing
+	// an element never shifts the index of one still being iterated over.
+	destroyedMeteors := make(map[int]bool)
+	destroyedBullets := make(map[int]bool)
+	var spawnedMeteors []*Meteor
+
+	for j, b := range g.bullets {
+		for _, i := range g.meteorGrid.nearby(b.Center()) {
+			if destroyedMeteors[i] || destroyedBullets[j] {
+				continue
+			}
+			m := g.meteors[i]
+			if !circlesIntersect(m.Center(), m.Radius(), b.Center(), b.Radius()) {
+				continue
 			}
+
+			destroyedMeteors[i] = true
+			destroyedBullets[j] = true
+			g.score += m.size.score()
+			g.bumpShake(3)
+			g.sounds.Play(assets.SoundExplosion)
+			g.explosionFlashes = append(g.explosionFlashes, explosionFlash{
+				position: m.position,
+				expiry:   time.Now().Add(400 * time.Millisecond),
+			})
+
+			if next, ok := m.size.next(); ok {
+				spawnedMeteors = append(spawnedMeteors,
+					splitMeteor(m, next, meteorSplitAngle),
+					splitMeteor(m, next, -meteorSplitAngle),
+				)
+			}
+
+			if rand.Float64() < powerupDropChance {
+				kind := allPowerupKinds[rand.Intn(len(allPowerupKinds))]
+				g.powerups = append(g.powerups, NewPowerup(m.position, kind))
+			}
+
+			break
 		}
 	}
 
-	// Check for meteor/player collisions
+	liveMeteors := g.meteors[:0]
 	for i, m := range g.meteors {
-		if m.Collider().Intersects(g.player.Collider()) {
-			g.meteors = append(g.meteors[:i], g.meteors[i+1:]...)
-			g.score--
+		if !destroyedMeteors[i] {
+			liveMeteors = append(liveMeteors, m)
 		}
 	}
+	g.meteors = append(liveMeteors, spawnedMeteors...)
 
-	g.score = max(g.score, 0)
-	return nil
+	liveBullets := g.bullets[:0]
+	for j, b := range g.bullets {
+		if !destroyedBullets[j] {
+			liveBullets = append(liveBullets, b)
+		}
+	}
+	g.bullets = liveBullets
+
+	// Check for meteor/player collisions. The grid was built against the
+	// pre-compaction meteor slice above, so rebuild it before this query.
+	g.meteorGrid = newSpatialGrid(spatialCellSize)
+	for i, m := range g.meteors {
+		g.meteorGrid.insert(i, m.Center())
+	}
+
+	destroyedMeteors = make(map[int]bool)
+	playerCenter, playerRadius := g.player.Center(), g.player.Radius()
+	for _, i := range g.meteorGrid.nearby(playerCenter) {
+		if destroyedMeteors[i] {
+			continue
+		}
+		m := g.meteors[i]
+		if !circlesIntersect(m.Center(), m.Radius(), playerCenter, playerRadius) {
+			continue
+		}
+
+		destroyedMeteors[i] = true
+
+		if g.player.hasEffect(PowerupShield) {
+			g.player.consumeEffect(PowerupShield)
+		} else {
+			g.lives--
+		}
+		g.bumpShake(8)
+		g.sounds.Play(assets.SoundHit)
+	}
+
+	liveMeteors = g.meteors[:0]
+	for i, m := range g.meteors {
+		if !destroyedMeteors[i] {
+			liveMeteors = append(liveMeteors, m)
+		}
+	}
+	g.meteors = liveMeteors
+
+	// Check for powerup pickups. Powerup counts stay small, so a brute-force
+	// scan is fine as long as it compacts after the loop rather than during.
+	destroyedPowerups := make(map[int]bool)
+	for i, pu := range g.powerups {
+		if circlesIntersect(pu.Center(), pu.Radius(), playerCenter, playerRadius) {
+			destroyedPowerups[i] = true
+			g.player.activate(pu.kind)
+			g.sounds.Play(assets.SoundPowerup)
+		}
+	}
+
+	livePowerups := g.powerups[:0]
+	for i, pu := range g.powerups {
+		if !destroyedPowerups[i] {
+			livePowerups = append(livePowerups, pu)
+		}
+	}
+	g.powerups = livePowerups
+
+	if g.lives <= 0 {
+		g.state = StateGameOver
+		g.showFlash(fmt.Sprintf("Game Over — Score %06d", g.score), time.Hour)
+		return
+	}
+
+	if g.score >= level.scoreToAdvance {
+		g.state = StateLevelComplete
+		g.showFlash(fmt.Sprintf("Level %d", g.levelNum+1), 2*time.Second)
+	}
 }
 
 func (g *Game) Draw(screen *ebiten.Image) {
+	switch g.state {
+	case StateMenu:
+		g.drawMenu(screen)
+	case StatePlaying:
+		g.drawPlaying(screen)
+	case StatePaused:
+		g.drawPlaying(screen)
+		g.drawFlash(screen)
+	case StateGameOver:
+		g.drawPlaying(screen)
+		g.drawFlash(screen)
+	case StateLevelComplete:
+		g.drawPlaying(screen)
+		g.drawFlash(screen)
+	}
+}
+
+func (g *Game) drawMenu(screen *ebiten.Image) {
+	op := &text.DrawOptions{}
+	op.GeoM.Translate(ScreenWidth/2-150, ScreenHeight/2-60)
+	op.ColorScale.ScaleWithColor(color.White)
+	text.Draw(screen, "METEORS", &text.GoTextFace{
+		Source: ScoreFont,
+		Size:   64,
+	}, op)
+
+	op = &text.DrawOptions{}
+	op.GeoM.Translate(ScreenWidth/2-140, ScreenHeight/2+20)
+	op.ColorScale.ScaleWithColor(color.White)
+	text.Draw(screen, "Press Space to start", &text.GoTextFace{
+		Source: ScoreFont,
+		Size:   24,
+	}, op)
+}
+
+func (g *Game) drawFlash(screen *ebiten.Image) {
+	if time.Now().After(g.flash.expiry) {
+		return
+	}
+
+	op := &text.DrawOptions{}
+	op.GeoM.Translate(ScreenWidth/2-120, ScreenHeight/2-20)
+	op.ColorScale.ScaleWithColor(color.White)
+	text.Draw(screen, g.flash.text, &text.GoTextFace{
+		Source: ScoreFont,
+		Size:   32,
+	}, op)
+}
+
+func (g *Game) drawPlaying(screen *ebiten.Image) {
+	// While the camera is shaking, render the scene to an offscreen buffer
+	// first so the shake can be applied as a single translate on composite,
+	// rather than having to thread it through every entity's Draw call.
+	target := screen
+	shaking := g.cameraShake > 0.01
+	if shaking {
+		if g.renderBuffer == nil {
+			g.renderBuffer = ebiten.NewImage(ScreenWidth, ScreenHeight)
+		}
+		g.renderBuffer.Clear()
+		target = g.renderBuffer
+	}
+
+	g.background.Draw(target, g.cameraOffset())
+
+	// Bullets are expendable and simply despawn off-screen; the player and
+	// meteors wrap toroidally instead (see wrap/wrapDrawOffsets).
 	outOfBoundsW := ScreenWidth * 1.5
 	outOfBoundsH := ScreenHeight * 1.5
-	g.player.Draw(screen)
+	g.player.Draw(target)
 
-	i := 0 // output index
 	for _, m := range g.meteors {
-		m.Draw(screen)
-		if math.Abs(m.position.X) < outOfBoundsW && math.Abs(m.position.Y) < outOfBoundsH {
-			g.meteors[i] = m
-			i++
-		}
+		m.Draw(target)
 	}
-	g.meteors = g.meteors[:i]
 
-	i = 0 // output index
+	i := 0 // output index
 	for _, b := range g.bullets {
-		b.Draw(screen)
+		b.Draw(target)
 		if math.Abs(b.position.X) < outOfBoundsW && math.Abs(b.position.Y) < outOfBoundsH {
 			g.bullets[i] = b
 			i++
@@ -471,14 +1466,34 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	}
 	g.bullets = g.bullets[:i]
 
+	i = 0 // output index
+	for _, pu := range g.powerups {
+		pu.Draw(target)
+		if math.Abs(pu.position.X) < outOfBoundsW && math.Abs(pu.position.Y) < outOfBoundsH {
+			g.powerups[i] = pu
+			i++
+		}
+	}
+	g.powerups = g.powerups[:i]
+
+	g.lighting.Draw(target, g.lightSources(), g.currentBrightness())
+
 	// Draw the sample text
 	op := &text.DrawOptions{}
 	op.GeoM.Translate(ScreenWidth/2-100, 50)
 	op.ColorScale.ScaleWithColor(color.White)
-	text.Draw(screen, fmt.Sprintf("%06d", g.score), &text.GoTextFace{
+	text.Draw(target, fmt.Sprintf("%06d", g.score), &text.GoTextFace{
 		Source: ScoreFont,
 		Size:   48,
 	}, op)
+
+	if shaking {
+		shakeOp := &ebiten.DrawImageOptions{}
+		dx := (rand.Float64()*2 - 1) * g.cameraShake
+		dy := (rand.Float64()*2 - 1) * g.cameraShake
+		shakeOp.GeoM.Translate(dx, dy)
+		screen.DrawImage(g.renderBuffer, shakeOp)
+	}
 }
 
 func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
@@ -490,10 +1505,16 @@ func (g *Game) AddBullet(b *Bullet) {
 }
 
 func main() {
-	g := &Game{meteorSpawnTimer: NewTimer(meteorSpawnTime)}
-	g.player = NewPlayer(g)
+	audioContext := audio.NewContext(sampleRate)
+	sounds, err := assets.LoadSounds(audioContext)
+	if err != nil {
+		panic(err)
+	}
+	sounds.PlayMusic(0.5)
+
+	g := &Game{state: StateMenu, sounds: sounds}
 
-	err := ebiten.RunGame(g)
+	err = ebiten.RunGame(g)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// benchmarkSprite is a small placeholder image used for every entity in the
+// benchmark in place of the loaded sprites. The package-level sprite
+// variables are still loaded from the embedded assets when the test binary
+// starts.
+func benchmarkSprite() *ebiten.Image {
+	return ebiten.NewImage(16, 16)
+}
+
+// newBenchmarkGame builds a Game populated with meteorCount meteors and
+// bulletCount bullets spread across the playfield, without calling
+// NewPlayer or NewMeteor, which use the loaded sprites.
+func newBenchmarkGame(meteorCount, bulletCount int) *Game {
+	sprite := benchmarkSprite()
+	level := levelFor(1)
+
+	g := &Game{
+		player: &Player{
+			position: Vector{X: ScreenWidth / 2, Y: ScreenHeight / 2},
+			sprite:   sprite,
+		},
+		lives: startingLives,
+	}
+
+	for i := 0; i < meteorCount; i++ {
+		angle := float64(i) * 2 * math.Pi / float64(meteorCount)
+		g.meteors = append(g.meteors, &Meteor{
+			position: Vector{
+				X: ScreenWidth/2 + math.Cos(angle)*float64(i%300),
+				Y: ScreenHeight/2 + math.Sin(angle)*float64(i%300),
+			},
+			movement: Vector{X: level.meteorMinSpeed, Y: 0},
+			sprite:   sprite,
+			size:     SizeLarge,
+		})
+	}
+
+	for i := 0; i < bulletCount; i++ {
+		g.bullets = append(g.bullets, &Bullet{
+			position: Vector{
+				X: float64(i % ScreenWidth),
+				Y: float64((i * 7) % ScreenHeight),
+			},
+			sprite: sprite,
+		})
+	}
+
+	return g
+}
+
+// BenchmarkCollisions exercises the spatial-grid broad phase against a
+// meteor/bullet count well above what a real run ever reaches. It runs its
+// own copy of the grid build and bullet/meteor pass from updatePlaying
+// rather than calling updatePlaying, so changes to that method do not show
+// up here.
+func BenchmarkCollisions(b *testing.B) {
+	const meteorCount = 1000
+	const bulletCount = 200
+
+	for i := 0; i < b.N; i++ {
+		g := newBenchmarkGame(meteorCount, bulletCount)
+
+		g.meteorGrid = newSpatialGrid(spatialCellSize)
+		for idx, m := range g.meteors {
+			g.meteorGrid.insert(idx, m.Center())
+		}
+
+		destroyedMeteors := make(map[int]bool)
+		destroyedBullets := make(map[int]bool)
+
+		for j, bullet := range g.bullets {
+			for _, idx := range g.meteorGrid.nearby(bullet.Center()) {
+				if destroyedMeteors[idx] || destroyedBullets[j] {
+					continue
+				}
+				m := g.meteors[idx]
+				if circlesIntersect(m.Center(), m.Radius(), bullet.Center(), bullet.Radius()) {
+					destroyedMeteors[idx] = true
+					destroyedBullets[j] = true
+					break
+				}
+			}
+		}
+	}
+}